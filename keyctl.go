@@ -4,13 +4,49 @@
 package keyring
 
 import (
+	"bytes"
 	"errors"
 	"fmt"
+	"io"
 	"syscall"
+	"time"
 
 	"github.com/jsipprell/keyctl"
 )
 
+// Note on DocLambda/keyring#chunk0-1 (kernel "persistent" keyring scope):
+// the request's claim that jsipprell/keyctl exposes an AttachPersistent()
+// method is wrong — there is no such method, and no KEYCTL_GET_PERSISTENT
+// wrapper anywhere in that library (checked v1.0.0 through v1.0.3). Worse,
+// even a from-scratch raw-syscall implementation couldn't return a usable
+// result here: the library's Keyring implementations are unexported structs
+// with no public constructor from a bare key serial, so there is no way to
+// wrap the kernel ID KEYCTL_GET_PERSISTENT hands back into something
+// Search/Add/etc. can use. The "persistent" scope and
+// KeyCtlPersistentTimeout are dropped rather than shipped broken.
+//
+// Note on DocLambda/keyring#chunk0-4 (pluggable Argon2id KDF for the file
+// backend): this request asks for a KDFConfig on Config (scrypt vs.
+// argon2id, tunable time/memory/parallelism/salt/key length), a per-file KDF
+// header so older files keep decrypting, a keyring.LoadConfig(path) loader,
+// and re-encryption-on-Set migration. That's all real work on the file
+// backend, which isn't part of this tree — it only carries the keyctl
+// backend, which is kernel-backed and has no KDF of its own to make
+// pluggable. Left unimplemented here.
+
+// ErrRotationNotSupported is returned by Keyring.Rotate implementations on
+// backends where the OS, not this package, owns the key-encryption key.
+//
+// NOTE: this belongs next to ErrKeyNotFound/ErrMetadataNotSupported in the
+// package's central errors file; it's declared here because this tree only
+// carries the keyctl backend, and keyctl is one of the backends that needs
+// it. The Keyring.Rotate interface method and the file/encryptedfile
+// implementations that do the real re-encryption work (manifest of
+// installed key IDs/KDF salts, two-phase write-.new/fsync/rename
+// re-encryption, crash recovery) live outside this tree entirely — only the
+// keyctl side of DocLambda/keyring#chunk0-3 is implemented here.
+var ErrRotationNotSupported = errors.New("key rotation not supported by this backend")
+
 type keyctlKeyring struct {
 	keyring keyctl.Keyring
 	perm    keyctl.KeyPerm
@@ -72,10 +108,161 @@ func (k *keyctlKeyring) Get(name string) (Item, error) {
 	return item, nil
 }
 
-// GetMetadata for pass returns an error indicating that it's unsupported for this backend.
-// TODO: We can deliver metadata different from the defined ones (e.g. permissions, expire-time, etc).
-func (k *keyctlKeyring) GetMetadata(_ string) (Metadata, error) {
-	return Metadata{}, ErrMetadataNotSupported
+// Note on DocLambda/keyring#chunk0-5 (Keyring-interface-level streaming API):
+// the request asks for GetReader/SetWriter to be added to the Keyring
+// interface itself, with default implementations wrapping Get/Set for
+// backends that don't stream. The Keyring interface lives outside this tree
+// (this tree only carries the keyctl backend), so that interface-level change
+// and the default wrappers aren't implemented here — only the keyctl-specific
+// methods below are.
+//
+// GetReader returns name's payload as a stream. jsipprell/keyctl only
+// exposes Key.Get(), which already issues the growing-buffer KEYCTL_READ
+// calls internally and hands back one fully materialized []byte — there is
+// no lower-level call in the library to read a key incrementally. Wrapping
+// that result in a pipe and a goroutine would add a second full copy in
+// flight plus a goroutine that leaks if the caller never drains or closes
+// the reader, so GetReader just hands back a buffered Reader over the
+// payload key.Get() already allocated.
+func (k *keyctlKeyring) GetReader(name string) (io.ReadCloser, error) {
+	key, err := k.keyring.Search(name)
+	if err != nil {
+		if errors.Is(err, syscall.ENOKEY) {
+			return nil, ErrKeyNotFound
+		}
+		return nil, err
+	}
+
+	data, err := key.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+// SetWriter returns a stream that, once fully written and closed, stores its
+// contents under name. It wires through the same session-keyring-then-link
+// dance as Set so that k.perm still applies to the result.
+func (k *keyctlKeyring) SetWriter(name string) (io.WriteCloser, error) {
+	pr, pw := io.Pipe()
+	done := make(chan error, 1)
+
+	go func() {
+		data, err := io.ReadAll(pr)
+		if err != nil {
+			done <- err
+			return
+		}
+		done <- k.Set(Item{Key: name, Data: data})
+	}()
+
+	return &keyctlSetWriter{pw: pw, done: done}, nil
+}
+
+type keyctlSetWriter struct {
+	pw   *io.PipeWriter
+	done chan error
+}
+
+func (w *keyctlSetWriter) Write(p []byte) (int, error) {
+	return w.pw.Write(p)
+}
+
+func (w *keyctlSetWriter) Close() error {
+	if err := w.pw.Close(); err != nil {
+		return err
+	}
+	return <-w.done
+}
+
+// KeyCtlPerm mirrors github.com/jsipprell/keyctl's KeyPerm without leaking
+// that vendor type through this package's public surface, matching
+// Config.KeyCtlPerm's existing convention.
+type KeyCtlPerm keyctl.KeyPerm
+
+// KeyCtlMetadata carries the kernel-level attributes of a keyctl-backed item
+// that don't fit the backend-agnostic Metadata shape (possession-based
+// ACLs). Fetch it with GetKeyCtlMetadata.
+//
+// There is no Expiry field: KEYCTL_DESCRIBE (what Key.Info() reads) only
+// ever returns type;uid;gid;perm;description — the kernel doesn't hand back
+// a key's remaining time-to-live through that call, or any other call this
+// library wraps, so there is nothing to populate it from.
+type KeyCtlMetadata struct {
+	Type        string
+	UID         int
+	GID         int
+	Perm        KeyCtlPerm
+	Description string
+}
+
+// GetMetadata returns the backend-agnostic Metadata for name. keyctl has no
+// notion of modification time, so only presence/absence of the key is
+// reported; use GetKeyCtlMetadata for permissions and description.
+func (k *keyctlKeyring) GetMetadata(name string) (Metadata, error) {
+	if _, err := k.keyring.Search(name); err != nil {
+		if errors.Is(err, syscall.ENOKEY) {
+			return Metadata{}, ErrKeyNotFound
+		}
+		return Metadata{}, err
+	}
+
+	return Metadata{}, nil
+}
+
+// GetKeyCtlMetadata reads the kernel's view of name: its type, owning UID/GID,
+// possession-based permission bitmask and description, via the same
+// KEYCTL_DESCRIBE call that backs Key.Info().
+func (k *keyctlKeyring) GetKeyCtlMetadata(name string) (KeyCtlMetadata, error) {
+	key, err := k.keyring.Search(name)
+	if err != nil {
+		if errors.Is(err, syscall.ENOKEY) {
+			return KeyCtlMetadata{}, ErrKeyNotFound
+		}
+		return KeyCtlMetadata{}, err
+	}
+
+	info, err := key.Info()
+	if err != nil {
+		return KeyCtlMetadata{}, err
+	}
+
+	return KeyCtlMetadata{
+		Type:        info.Type,
+		UID:         info.Uid,
+		GID:         info.Gid,
+		Perm:        KeyCtlPerm(info.Perm),
+		Description: info.Name,
+	}, nil
+}
+
+// SetMetadata sets perm and ttl on an existing item. Unlike Set it does not go
+// through the session keyring: it changes the key found in k.keyring in place,
+// so the caller's current permissions on that key must allow setattr. Both
+// values are applied exactly as given: a zero ttl clears the key's
+// expiration back to permanent (Key.ExpireAfter(0) semantics), and a zero
+// perm strips all permissions. The ttl is applied before perm, so a perm that
+// drops setattr does not block the ttl change. GetKeyCtlMetadata reports the
+// current perm; the current ttl cannot be read back, so it is always replaced.
+func (k *keyctlKeyring) SetMetadata(name string, perm KeyCtlPerm, ttl time.Duration) error {
+	key, err := k.keyring.Search(name)
+	if err != nil {
+		if errors.Is(err, syscall.ENOKEY) {
+			return ErrKeyNotFound
+		}
+		return err
+	}
+
+	if err := key.ExpireAfter(uint(ttl.Seconds())); err != nil {
+		return fmt.Errorf("setting timeout %s failed: %v", ttl, err)
+	}
+
+	if err := keyctl.SetPerm(key, keyctl.KeyPerm(perm)); err != nil {
+		return fmt.Errorf("setting permission %q failed: %v", perm, err)
+	}
+
+	return nil
 }
 
 func (k *keyctlKeyring) Set(item Item) error {
@@ -124,6 +311,15 @@ func (k *keyctlKeyring) Remove(name string) error {
 	return key.Unlink()
 }
 
+// Rotate is not supported on keyctl: the kernel owns the key-encryption key
+// for everything stored here, so there is no passphrase/key for us to
+// re-encrypt under. This mirrors the same default on the other OS-backed
+// backends (keychain, secret-service, wincred); only the file/encryptedfile
+// backends, which manage their own KEK, can implement real rotation.
+func (k *keyctlKeyring) Rotate(_ []byte) error {
+	return ErrRotationNotSupported
+}
+
 func (k *keyctlKeyring) Keys() ([]string, error) {
 	results := []string{}
 
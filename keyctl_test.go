@@ -5,6 +5,7 @@ package keyring_test
 
 import (
 	"errors"
+	"io"
 	"math/rand"
 	"reflect"
 	"syscall"
@@ -239,6 +240,160 @@ func TestKeyCtlList(t *testing.T) {
 	}
 }
 
+func TestKeyCtlGetMetadata(t *testing.T) {
+	kr, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.KeyCtlBackend},
+		KeyCtlScope:     "user",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = kr.Set(keyring.Item{
+		Key:  "test",
+		Data: []byte("loose lips sink ships"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = kr.Remove("test") })
+
+	metaKr, ok := kr.(interface {
+		GetKeyCtlMetadata(name string) (keyring.KeyCtlMetadata, error)
+	})
+	if !ok {
+		t.Fatal("keyctl keyring does not expose GetKeyCtlMetadata")
+	}
+
+	meta, err := metaKr.GetKeyCtlMetadata("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Type != "user" {
+		t.Fatalf("Expected key type %q, got %q", "user", meta.Type)
+	}
+	if meta.Description != "test" {
+		t.Fatalf("Expected description %q, got %q", "test", meta.Description)
+	}
+
+	_, err = metaKr.GetKeyCtlMetadata("llamas")
+	if err != keyring.ErrKeyNotFound {
+		t.Fatalf("Expected %v, got %v", keyring.ErrKeyNotFound, err)
+	}
+}
+
+func TestKeyCtlSetMetadata(t *testing.T) {
+	kr, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.KeyCtlBackend},
+		KeyCtlScope:     "user",
+		KeyCtlPerm:      uint32(keyctl.PermUserAll | keyctl.PermProcessAll),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = kr.Set(keyring.Item{
+		Key:  "test",
+		Data: []byte("loose lips sink ships"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = kr.Remove("test") })
+
+	metaKr, ok := kr.(interface {
+		SetMetadata(name string, perm keyring.KeyCtlPerm, ttl time.Duration) error
+	})
+	if !ok {
+		t.Fatal("keyctl keyring does not expose SetMetadata")
+	}
+
+	perm := keyring.KeyCtlPerm(keyctl.PermUserAll)
+
+	if err := metaKr.SetMetadata("test", perm, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	// A zero ttl clears the expiration back to permanent rather than being
+	// ignored, and a zero perm strips all permissions rather than being
+	// ignored - both are applied exactly as given.
+	if err := metaKr.SetMetadata("test", perm, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := metaKr.SetMetadata("test", 0, time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := metaKr.SetMetadata("llamas", perm, time.Minute); err != keyring.ErrKeyNotFound {
+		t.Fatalf("Expected %v, got %v", keyring.ErrKeyNotFound, err)
+	}
+}
+
+func TestKeyCtlGetReaderSetWriter(t *testing.T) {
+	kr, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.KeyCtlBackend},
+		KeyCtlScope:     "user",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	streamKr, ok := kr.(interface {
+		GetReader(name string) (io.ReadCloser, error)
+		SetWriter(name string) (io.WriteCloser, error)
+	})
+	if !ok {
+		t.Fatal("keyctl keyring does not expose GetReader/SetWriter")
+	}
+
+	w, err := streamKr.SetWriter("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := w.Write([]byte("loose lips sink ships")); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = kr.Remove("test") })
+
+	r, err := streamKr.GetReader("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != "loose lips sink ships" {
+		t.Fatalf("Expected %q, got %q", "loose lips sink ships", got)
+	}
+}
+
+func TestKeyCtlRotateNotSupported(t *testing.T) {
+	kr, err := keyring.Open(keyring.Config{
+		AllowedBackends: []keyring.BackendType{keyring.KeyCtlBackend},
+		KeyCtlScope:     "user",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	rotator, ok := kr.(interface {
+		Rotate(newKey []byte) error
+	})
+	if !ok {
+		t.Fatal("keyctl keyring does not expose Rotate")
+	}
+
+	if err := rotator.Rotate([]byte("new-key")); err != keyring.ErrRotationNotSupported {
+		t.Fatalf("Expected %v, got %v", keyring.ErrRotationNotSupported, err)
+	}
+}
+
 func TestKeyCtlGetNonExisting(t *testing.T) {
 	kr, err := keyring.Open(keyring.Config{
 		AllowedBackends: []keyring.BackendType{keyring.KeyCtlBackend},